@@ -0,0 +1,337 @@
+package courtsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esutil"
+	"github.com/olivere/elastic"
+)
+
+var errNotAcknowledged = errors.New("index creation was not acknowledged")
+
+func errBulkItemFailed(reason string) error {
+	return errors.New("bulk item failed: " + reason)
+}
+
+func errElasticResponse(body string) error {
+	return errors.New("elasticsearch error response: " + body)
+}
+
+func encodeJSON(doc interface{}) (io.Reader, error) {
+	payload, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(payload), nil
+}
+
+func decodeJSON(body io.Reader, target interface{}) error {
+	return json.NewDecoder(body).Decode(target)
+}
+
+// BulkResultFunc receives the outcome of a single document submitted through a
+// backend's bulk mode. err is nil on success.
+type BulkResultFunc func(id string, err error)
+
+// ElasticBackend abstracts the handful of Elasticsearch/OpenSearch operations
+// the engine needs, so the SDK is not hard-wired to a single client major
+// version. Select an implementation with the Backend or BackendVersion engine
+// options. Every method takes the caller's ctx as its parent, so the Context,
+// SetIndexDeadline and SetPersistDeadline engine options can bound or cancel it.
+// The olivere backend's BulkIndexDocument, BulkFlush and Close do not use ctx.
+type ElasticBackend interface {
+	// Ping checks the cluster is reachable and returns its reported version.
+	Ping(ctx context.Context) (version string, err error)
+	// EnsureIndex creates index with the given mapping if it does not already exist.
+	EnsureIndex(ctx context.Context, index string, mapping string) error
+	// IndexDocument stores doc under id in index, synchronously.
+	IndexDocument(ctx context.Context, index string, id string, doc interface{}) error
+	// EnableBulk turns on bulk mode, reporting each document's outcome through after.
+	EnableBulk(ctx context.Context, workers int, actions int, sizeBytes int, flushInterval time.Duration, after BulkResultFunc) error
+	// BulkIndexDocument queues doc under id in index for the next bulk commit.
+	BulkIndexDocument(ctx context.Context, index string, id string, doc interface{})
+	// BulkFlush forces any queued documents to be committed. Note: a backend may have no
+	// non-terminal flush primitive (the v8 backend doesn't) and treat this as a one-time
+	// close instead; calling it again is a safe no-op, but no further document can be
+	// indexed through that backend afterward.
+	BulkFlush(ctx context.Context) error
+	// Close releases any resources held by the backend, flushing bulk mode first.
+	Close(ctx context.Context) error
+}
+
+//olivereBackend - ElasticBackend implementation wrapping github.com/olivere/elastic (ES 5/6/7).
+type olivereBackend struct {
+	client    *elastic.Client
+	processor *elastic.BulkProcessor
+}
+
+//newOlivereBackend - connect to elasticFullURL using the olivere client.
+func newOlivereBackend(elasticFullURL string) (*olivereBackend, error) {
+	client, err := elastic.NewClient(elastic.SetSniff(false), elastic.SetURL(elasticFullURL))
+	if err != nil {
+		return nil, err
+	}
+	return &olivereBackend{client: client}, nil
+}
+
+func (backend *olivereBackend) Ping(ctx context.Context) (string, error) {
+	info, _, err := backend.client.Ping(backend.client.URLs()[0]).Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.Version.Number, nil
+}
+
+func (backend *olivereBackend) EnsureIndex(ctx context.Context, index string, mapping string) error {
+	exists, err := backend.client.IndexExists(index).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	createIndex, err := backend.client.CreateIndex(index).BodyString(mapping).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if !createIndex.Acknowledged {
+		return errNotAcknowledged
+	}
+	return nil
+}
+
+func (backend *olivereBackend) IndexDocument(ctx context.Context, index string, id string, doc interface{}) error {
+	_, err := backend.client.Index().
+		Index(index).
+		Id(id).
+		BodyJson(doc).
+		Do(ctx)
+	return err
+}
+
+func (backend *olivereBackend) EnableBulk(ctx context.Context, workers int, actions int, sizeBytes int, flushInterval time.Duration, after BulkResultFunc) error {
+	builder := backend.client.BulkProcessor().After(func(executionID int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+		if err != nil {
+			for range requests {
+				after("", err)
+			}
+			return
+		}
+		for _, item := range response.Failed() {
+			after(item.Id, errBulkItemFailed(item.Error.Reason))
+		}
+		for _, item := range response.Succeeded() {
+			after(item.Id, nil)
+		}
+	})
+	if workers > 0 {
+		builder = builder.Workers(workers)
+	}
+	if actions > 0 {
+		builder = builder.BulkActions(actions)
+	}
+	if sizeBytes > 0 {
+		builder = builder.BulkSize(sizeBytes)
+	}
+	if flushInterval > 0 {
+		builder = builder.FlushInterval(flushInterval)
+	}
+	processor, err := builder.Do(ctx)
+	if err != nil {
+		return err
+	}
+	backend.processor = processor
+	return nil
+}
+
+func (backend *olivereBackend) BulkIndexDocument(ctx context.Context, index string, id string, doc interface{}) {
+	if backend.processor == nil {
+		return
+	}
+	request := elastic.NewBulkIndexRequest().Index(index).Id(id).Doc(doc)
+	backend.processor.Add(request)
+}
+
+func (backend *olivereBackend) BulkFlush(ctx context.Context) error {
+	if backend.processor == nil {
+		return nil
+	}
+	return backend.processor.Flush()
+}
+
+func (backend *olivereBackend) Close(ctx context.Context) error {
+	if backend.processor != nil {
+		if err := backend.BulkFlush(ctx); err != nil {
+			return err
+		}
+		if err := backend.processor.Close(); err != nil {
+			return err
+		}
+	}
+	return backend.client.Stop()
+}
+
+//v8Backend - ElasticBackend implementation wrapping github.com/elastic/go-elasticsearch/v8.
+type v8Backend struct {
+	client   *elasticsearch.Client
+	indexer  esutil.BulkIndexer
+	after    BulkResultFunc
+	closedMu sync.Mutex
+	closed   bool
+}
+
+//newV8Backend - connect to elasticFullURL using the go-elasticsearch v8 client.
+func newV8Backend(elasticFullURL string) (*v8Backend, error) {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{elasticFullURL}})
+	if err != nil {
+		return nil, err
+	}
+	return &v8Backend{client: client}, nil
+}
+
+func (backend *v8Backend) Ping(ctx context.Context) (string, error) {
+	response, err := backend.client.Info(backend.client.Info.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.IsError() {
+		return "", errElasticResponse(response.String())
+	}
+	var info struct {
+		Version struct {
+			Number string `json:"number"`
+		} `json:"version"`
+	}
+	if err := decodeJSON(response.Body, &info); err != nil {
+		return "", err
+	}
+	return info.Version.Number, nil
+}
+
+func (backend *v8Backend) EnsureIndex(ctx context.Context, index string, mapping string) error {
+	existsResponse, err := backend.client.Indices.Exists([]string{index}, backend.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer existsResponse.Body.Close()
+	if existsResponse.StatusCode == 200 {
+		return nil
+	}
+	createResponse, err := backend.client.Indices.Create(
+		index,
+		backend.client.Indices.Create.WithBody(strings.NewReader(mapping)),
+		backend.client.Indices.Create.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer createResponse.Body.Close()
+	if createResponse.IsError() {
+		return errElasticResponse(createResponse.String())
+	}
+	return nil
+}
+
+func (backend *v8Backend) IndexDocument(ctx context.Context, index string, id string, doc interface{}) error {
+	body, err := encodeJSON(doc)
+	if err != nil {
+		return err
+	}
+	response, err := backend.client.Index(index, body, backend.client.Index.WithDocumentID(id), backend.client.Index.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.IsError() {
+		return errElasticResponse(response.String())
+	}
+	return nil
+}
+
+func (backend *v8Backend) EnableBulk(ctx context.Context, workers int, actions int, sizeBytes int, flushInterval time.Duration, after BulkResultFunc) error {
+	// esutil.BulkIndexer has no action-count flush trigger, unlike olivere's BulkActions.
+	// When actions is the only bulk knob configured, fall back to a time-based flush so
+	// documents still stream instead of only ever committing at Close()/shutdown.
+	if actions > 0 && sizeBytes <= 0 && flushInterval <= 0 {
+		flushInterval = 5 * time.Second
+	}
+	indexer, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
+		Client:        backend.client,
+		NumWorkers:    workers,
+		FlushBytes:    sizeBytes,
+		FlushInterval: flushInterval,
+		OnError: func(ctx context.Context, err error) {
+			after("", err)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	backend.indexer = indexer
+	backend.after = after
+	return nil
+}
+
+func (backend *v8Backend) BulkIndexDocument(ctx context.Context, index string, id string, doc interface{}) {
+	if backend.indexer == nil {
+		return
+	}
+	body, err := encodeJSON(doc)
+	if err != nil {
+		backend.after(id, err)
+		return
+	}
+	_ = backend.indexer.Add(ctx, esutil.BulkIndexerItem{
+		Index:      index,
+		Action:     "index",
+		DocumentID: id,
+		Body:       body,
+		OnSuccess: func(ctx context.Context, item esutil.BulkIndexerItem, _ esutil.BulkIndexerResponseItem) {
+			backend.after(item.DocumentID, nil)
+		},
+		OnFailure: func(ctx context.Context, item esutil.BulkIndexerItem, _ esutil.BulkIndexerResponseItem, err error) {
+			backend.after(item.DocumentID, err)
+		},
+	})
+}
+
+//BulkFlush - esutil.BulkIndexer exposes no non-terminal flush, only Close, so this commits
+//every queued document and then permanently stops the indexer. Safe to call more than
+//once: later calls are a no-op rather than a panic on the already-closed indexer, but no
+//further document can be indexed through this backend afterward.
+func (backend *v8Backend) BulkFlush(ctx context.Context) error {
+	if backend.indexer == nil {
+		return nil
+	}
+	backend.closedMu.Lock()
+	alreadyClosed := backend.closed
+	backend.closed = true
+	backend.closedMu.Unlock()
+	if alreadyClosed {
+		return nil
+	}
+	return backend.indexer.Close(ctx)
+}
+
+func (backend *v8Backend) Close(ctx context.Context) error {
+	return backend.BulkFlush(ctx)
+}
+
+//newDefaultBackend - build the ElasticBackend selected by version ("v7"/"" -> olivere, "v8" -> go-elasticsearch).
+func newDefaultBackend(version string, elasticFullURL string) (ElasticBackend, error) {
+	switch version {
+	case "v8":
+		return newV8Backend(elasticFullURL)
+	default:
+		return newOlivereBackend(elasticFullURL)
+	}
+}
@@ -0,0 +1,54 @@
+package courtsdk
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the delay before retry attempt `retry` (0-indexed) and
+// whether a retry should be attempted at all. Engines use it to pace
+// reconnect/ping/recovery loops instead of retrying at a hammering, fixed rate.
+type Backoff interface {
+	Next(retry int) (time.Duration, bool)
+}
+
+// ConstantBackoff waits a fixed Delay before every retry. MaxRetries caps the
+// number of retries (not counting the first attempt); 0 means unlimited. This mirrors the engine's original
+// fixed-delay retry behavior.
+type ConstantBackoff struct {
+	Delay      time.Duration
+	MaxRetries int
+}
+
+// Next implements Backoff.
+func (backoff ConstantBackoff) Next(retry int) (time.Duration, bool) {
+	if backoff.MaxRetries > 0 && retry >= backoff.MaxRetries {
+		return 0, false
+	}
+	return backoff.Delay, true
+}
+
+// ExponentialBackoff computes d = min(Max, Min * 2^retry) with full jitter
+// (a random delay in [0, d)), capping at MaxRetries retries; 0 means unlimited.
+type ExponentialBackoff struct {
+	Min        time.Duration
+	Max        time.Duration
+	MaxRetries int
+}
+
+// Next implements Backoff.
+func (backoff ExponentialBackoff) Next(retry int) (time.Duration, bool) {
+	if backoff.MaxRetries > 0 && retry >= backoff.MaxRetries {
+		return 0, false
+	}
+	shifted := backoff.Min * time.Duration(uint64(1)<<uint(retry))
+	// shifted overflows to a non-positive value for large retry counts; fall
+	// back to Max rather than let the overflow bypass the cap below.
+	if shifted <= 0 || (backoff.Max > 0 && shifted > backoff.Max) {
+		shifted = backoff.Max
+	}
+	if shifted <= 0 {
+		return 0, true
+	}
+	return time.Duration(rand.Int63n(int64(shifted))), true
+}
@@ -0,0 +1,79 @@
+package courtsdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConstantBackoffNext(t *testing.T) {
+	backoff := ConstantBackoff{Delay: 5 * time.Second, MaxRetries: 3}
+	for retry := 0; retry < 3; retry++ {
+		delay, retryable := backoff.Next(retry)
+		if !retryable {
+			t.Fatalf("retry %d: expected retryable, got false", retry)
+		}
+		if delay != 5*time.Second {
+			t.Fatalf("retry %d: expected delay 5s, got %v", retry, delay)
+		}
+	}
+	if _, retryable := backoff.Next(3); retryable {
+		t.Fatal("expected MaxRetries to stop retrying")
+	}
+}
+
+func TestExponentialBackoffJitterWithinBounds(t *testing.T) {
+	backoff := ExponentialBackoff{Min: 100 * time.Millisecond, Max: 10 * time.Second}
+	for retry := 0; retry < 10; retry++ {
+		upperBound := backoff.Min * time.Duration(uint64(1)<<uint(retry))
+		if upperBound > backoff.Max {
+			upperBound = backoff.Max
+		}
+		for i := 0; i < 20; i++ {
+			delay, retryable := backoff.Next(retry)
+			if !retryable {
+				t.Fatalf("retry %d: expected retryable, got false", retry)
+			}
+			if delay < 0 || delay >= upperBound {
+				t.Fatalf("retry %d: delay %v out of bounds [0, %v)", retry, delay, upperBound)
+			}
+		}
+	}
+}
+
+func TestExponentialBackoffHonorsMax(t *testing.T) {
+	backoff := ExponentialBackoff{Min: time.Second, Max: 2 * time.Second}
+	for retry := 0; retry < 10; retry++ {
+		delay, retryable := backoff.Next(retry)
+		if !retryable {
+			t.Fatalf("retry %d: expected retryable, got false", retry)
+		}
+		if delay >= backoff.Max {
+			t.Fatalf("retry %d: delay %v not capped by Max %v", retry, delay, backoff.Max)
+		}
+	}
+}
+
+func TestExponentialBackoffHonorsMaxOnOverflow(t *testing.T) {
+	backoff := ExponentialBackoff{Min: time.Second, Max: 2 * time.Second}
+	// retry=40 overflows Min*2^retry to a negative time.Duration; Max must still be honored.
+	delay, retryable := backoff.Next(40)
+	if !retryable {
+		t.Fatal("expected retryable, got false")
+	}
+	if delay < 0 || delay >= backoff.Max {
+		t.Fatalf("delay %v not within [0, Max=%v) after overflow", delay, backoff.Max)
+	}
+}
+
+func TestExponentialBackoffMaxRetries(t *testing.T) {
+	backoff := ExponentialBackoff{Min: time.Millisecond, Max: time.Second, MaxRetries: 2}
+	if _, retryable := backoff.Next(0); !retryable {
+		t.Fatal("retry 0: expected retryable")
+	}
+	if _, retryable := backoff.Next(1); !retryable {
+		t.Fatal("retry 1: expected retryable")
+	}
+	if _, retryable := backoff.Next(2); retryable {
+		t.Fatal("retry 2: expected MaxRetries to stop retrying")
+	}
+}
@@ -1,10 +1,12 @@
 package courtsdk
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gocolly/colly"
@@ -95,6 +97,74 @@ func Collector(collector *colly.Collector) func(*Engine) {
 func ElasticClient(client *elastic.Client) func(*Engine) {
 	return func(engine *Engine) {
 		engine.ElasticClient = client
+		engine.Backend = &olivereBackend{client: client}
+	}
+}
+
+// Backend set the engine's ElasticBackend directly, bypassing BackendVersion.
+func Backend(backend ElasticBackend) func(*Engine) {
+	return func(engine *Engine) {
+		engine.Backend = backend
+	}
+}
+
+// BackendVersion select which ElasticBackend implementation InitElastic builds
+// when no explicit Backend was given. Supported values are "v7" (default,
+// github.com/olivere/elastic) and "v8" (github.com/elastic/go-elasticsearch/v8).
+func BackendVersion(version string) func(*Engine) {
+	return func(engine *Engine) {
+		engine.BackendVersion = version
+	}
+}
+
+// BulkWorkers enables bulk indexing mode and sets the number of concurrent bulk workers.
+func BulkWorkers(n int) func(*Engine) {
+	return func(engine *Engine) {
+		engine.UseBulk = true
+		engine.BulkWorkers = n
+	}
+}
+
+// BulkActions sets how many add actions the bulk processor will commit after.
+// Only the olivere (v7) backend honors an action count directly; the v8 backend
+// has no such trigger and, when this is the only bulk knob set, falls back to a
+// time-based flush so documents still stream instead of only committing at Close().
+func BulkActions(n int) func(*Engine) {
+	return func(engine *Engine) {
+		engine.UseBulk = true
+		engine.BulkActionsLimit = n
+	}
+}
+
+// BulkSize sets the size (in bytes) the bulk processor will commit after.
+func BulkSize(bytes int) func(*Engine) {
+	return func(engine *Engine) {
+		engine.UseBulk = true
+		engine.BulkSizeLimit = bytes
+	}
+}
+
+// BulkFlushInterval sets the interval the bulk processor will commit after, regardless of size.
+func BulkFlushInterval(d time.Duration) func(*Engine) {
+	return func(engine *Engine) {
+		engine.UseBulk = true
+		engine.BulkFlushInterval = d
+	}
+}
+
+// HealthcheckInterval sets how often the engine pings Elasticsearch in the background
+// to detect outages. Defaults to 10 seconds.
+func HealthcheckInterval(d time.Duration) func(*Engine) {
+	return func(engine *Engine) {
+		engine.HealthcheckInterval = d
+	}
+}
+
+// OfflineBufferSize sets how many documents Persist will buffer in memory while
+// Elasticsearch is unavailable. Defaults to 1000.
+func OfflineBufferSize(size int) func(*Engine) {
+	return func(engine *Engine) {
+		engine.OfflineBufferSize = size
 	}
 }
 
@@ -126,6 +196,16 @@ func Lock(lock *sync.WaitGroup) func(*Engine) {
 	}
 }
 
+// ElasticBackoff set the Backoff strategy used to pace the Elasticsearch
+// reconnect/ping loop in InitElastic and the recovery loop in runAsSequential/
+// runAsConcurrent. Defaults to a ConstantBackoff driven by ElasticConfig/
+// ControlConfig delays, preserving the engine's original fixed-delay behavior.
+func ElasticBackoff(backoff Backoff) func(*Engine) {
+	return func(engine *Engine) {
+		engine.ElasticBackoff = backoff
+	}
+}
+
 // Concurrency set how many replicas and range (both greater than zero).
 func Concurrency(maxReplicas int, replicaRange int) func(*Engine) {
 	return func(engine *Engine) {
@@ -137,79 +217,346 @@ func Concurrency(maxReplicas int, replicaRange int) func(*Engine) {
 	}
 }
 
-//InitElastic - Initialize an Elasticsearch client with Elastic configs.
+// Context set the parent context for the engine's Elasticsearch requests and
+// its retry/recovery waits, so a caller can cancel the engine from outside.
+// Creating the backend's client connection and colly requests do not use it.
+func Context(ctx context.Context) func(*Engine) {
+	return func(engine *Engine) {
+		engine.Context = ctx
+	}
+}
+
+// SetIndexDeadline bounds the ping and ensure-index requests of InitElastic/
+// ConnectedToIndex, and the background healthcheck pings, with a deadline
+// relative to engine.Context. Creating the client connection is not bounded.
+func (engine *Engine) SetIndexDeadline(t time.Time) {
+	engine.IndexDeadline = t
+}
+
+// SetPersistDeadline bounds each Persist request with a deadline relative to engine.Context.
+// In bulk mode it covers only queueing the document; the bulk commit is not bounded by it.
+func (engine *Engine) SetPersistDeadline(t time.Time) {
+	engine.PersistDeadline = t
+}
+
+//parentContext - engine.Context, defaulting to context.Background() when unset.
+func (engine *Engine) parentContext() context.Context {
+	if engine.Context != nil {
+		return engine.Context
+	}
+	return context.Background()
+}
+
+//indexContext - context.Context for a connect/ping/ensure-index request, honoring IndexDeadline.
+func (engine *Engine) indexContext() (context.Context, context.CancelFunc) {
+	if !engine.IndexDeadline.IsZero() {
+		return context.WithDeadline(engine.parentContext(), engine.IndexDeadline)
+	}
+	return context.WithCancel(engine.parentContext())
+}
+
+//persistContext - context.Context for a Persist request, honoring PersistDeadline.
+func (engine *Engine) persistContext() (context.Context, context.CancelFunc) {
+	if !engine.PersistDeadline.IsZero() {
+		return context.WithDeadline(engine.parentContext(), engine.PersistDeadline)
+	}
+	return context.WithCancel(engine.parentContext())
+}
+
+//sleepOrStop - sleep for d, waking up early if engine.Context is cancelled first.
+//Returns true when the context was cancelled, so the caller should abort its retry loop.
+func (engine *Engine) sleepOrStop(d time.Duration) bool {
+	if engine.Context == nil {
+		time.Sleep(d)
+		return false
+	}
+	select {
+	case <-time.After(d):
+		return false
+	case <-engine.Context.Done():
+		log.Println("[INFO] Context cancelled, stopping Elasticsearch reconnect loop.")
+		return true
+	}
+}
+
+//InitElastic - Initialize the engine's ElasticBackend with Elastic configs.
 func (engine *Engine) InitElastic() {
-	var err error
+	if engine.Context != nil && engine.Context.Err() != nil {
+		log.Println("[INFO] Context already done, aborting Elasticsearch init.")
+		return
+	}
 	elasticFullURL := ElasticConfig["URL"].(string) + ":" + strconv.Itoa(ElasticConfig["Port"].(int))
-	engine.ElasticClient, err = elastic.NewClient(elastic.SetSniff(false), elastic.SetURL(elasticFullURL))
+	if engine.Backend == nil {
+		backoff := engine.backoffOrDefault(time.Duration(ElasticConfig["RetryConnectionDelay"].(int)) * time.Second)
+		for retry := 0; ; retry++ {
+			backend, err := newDefaultBackend(engine.BackendVersion, elasticFullURL)
+			if err == nil {
+				engine.Backend = backend
+				break
+			}
+			log.Println("[FAILED] Connect to Elasticsearch.", err)
+			delay, retryable := backoff.Next(retry)
+			if !retryable {
+				log.Println("[FAILED] Giving up connecting to Elasticsearch after", retry+1, "attempts.")
+				return
+			}
+			log.Println("[WARNING] Retrying in", delay, "...")
+			if engine.sleepOrStop(delay) {
+				return
+			}
+		}
+	}
+	backoff := engine.backoffOrDefault(time.Duration(ElasticConfig["RetryPingDelay"].(int)) * time.Second)
+	for retry := 0; !engine.pingElasticSearch(); retry++ {
+		delay, retryable := backoff.Next(retry)
+		if !retryable {
+			log.Println("[FAILED] Giving up pinging Elasticsearch after", retry+1, "attempts.")
+			return
+		}
+		log.Println("[WARNING] Retrying in", delay, "...")
+		if engine.sleepOrStop(delay) {
+			return
+		}
+	}
+	if engine.UseBulk {
+		engine.initBulkProcessor()
+	}
+	if engine.healthcheckStop == nil {
+		engine.startHealthcheck()
+	}
+}
+
+//startHealthcheck - ping Elasticsearch on a timer, pausing Persist via elasticAvailable
+//while the cluster is down and draining the offline buffer once it recovers.
+func (engine *Engine) startHealthcheck() {
+	interval := engine.HealthcheckInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	bufferSize := engine.OfflineBufferSize
+	if bufferSize <= 0 {
+		bufferSize = 1000
+	}
+	engine.offlineQueue = make(chan Jurisprudence, bufferSize)
+	engine.healthcheckStop = make(chan struct{})
+	engine.setElasticAvailable(true)
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		available := true
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancelContext := engine.indexContext()
+				_, err := engine.Backend.Ping(ctx)
+				cancelContext()
+				if err != nil {
+					log.Println("[WARNING] Elasticsearch healthcheck failed, buffering documents.", err)
+					available = false
+					engine.setElasticAvailable(false)
+					continue
+				}
+				if !available || !engine.isElasticAvailable() {
+					log.Println("[SUCCESS] Elasticsearch recovered, draining offline buffer.")
+					engine.setElasticAvailable(true)
+					engine.drainOfflineQueue()
+				}
+				available = true
+			case <-engine.healthcheckStop:
+				return
+			}
+		}
+	}()
+}
+
+//Stop - stop the background healthcheck goroutine, mirroring a clean ticker shutdown.
+func (engine *Engine) Stop() {
+	if engine.healthcheckStop != nil {
+		close(engine.healthcheckStop)
+		engine.healthcheckStop = nil
+	}
+}
+
+func (engine *Engine) isElasticAvailable() bool {
+	return atomic.LoadInt32(&engine.elasticAvailable) == 1
+}
+
+func (engine *Engine) setElasticAvailable(available bool) {
+	if available {
+		atomic.StoreInt32(&engine.elasticAvailable, 1)
+		return
+	}
+	atomic.StoreInt32(&engine.elasticAvailable, 0)
+}
+
+//bufferOffline - queue jurisprudence while Elasticsearch is unavailable, dropping it if the buffer is full.
+func (engine *Engine) bufferOffline(jurisprudence Jurisprudence) {
+	select {
+	case engine.offlineQueue <- jurisprudence:
+	default:
+		log.Println("[FAILED][OFFLINE] Buffer full, dropping document [" + jurisprudence.DocumentID + "]")
+		engine.sendStatus(http.StatusInternalServerError)
+	}
+}
+
+//drainOfflineQueue - flush the documents buffered as of this call back through Persist now
+//that Elasticsearch is reachable. Bounded to the queue depth seen at the start: a document
+//that fails again gets re-buffered by Persist, and draining only this many items keeps a
+//permanently-failing document from spinning this goroutine forever instead of ticking again
+//on the next healthcheck interval.
+//Safe to call from the healthcheck goroutine even during shutdown: Persist's status sends go
+//through sendStatus, which stops blocking once shuttingDown is set.
+func (engine *Engine) drainOfflineQueue() {
+	for pending := len(engine.offlineQueue); pending > 0; pending-- {
+		select {
+		case jurisprudence := <-engine.offlineQueue:
+			engine.Persist(jurisprudence)
+		default:
+			return
+		}
+	}
+}
+
+//initBulkProcessor - enable bulk mode on the engine's backend, falling back to
+//synchronous Persist when the backend fails to start bulk mode.
+//EnableBulk gets engine.parentContext(), not indexContext(): the olivere processor
+//retains the ctx it's built with and reuses it for every future commit, so a
+//deadline/cancel scoped to this one init call (as indexContext provides) would
+//cancel all later flushes as soon as initBulkProcessor returns.
+func (engine *Engine) initBulkProcessor() {
+	err := engine.Backend.EnableBulk(engine.parentContext(), engine.BulkWorkers, engine.BulkActionsLimit, engine.BulkSizeLimit, engine.BulkFlushInterval, engine.bulkAfterCallback)
+	if err != nil {
+		log.Println("[FAILED] Start Elasticsearch bulk processor.", err)
+		engine.UseBulk = false
+		return
+	}
+	engine.bulkPending = make(map[string]Jurisprudence)
+}
+
+//trackBulkPending - remember jurisprudence under id until bulkAfterCallback reports its outcome,
+//so a failed commit can be re-buffered through the offline queue instead of just losing the document.
+func (engine *Engine) trackBulkPending(id string, jurisprudence Jurisprudence) {
+	engine.bulkPendingMu.Lock()
+	defer engine.bulkPendingMu.Unlock()
+	if engine.bulkPending != nil {
+		engine.bulkPending[id] = jurisprudence
+	}
+}
+
+//takeBulkPending - remove and return the jurisprudence tracked under id, if any.
+func (engine *Engine) takeBulkPending(id string) (Jurisprudence, bool) {
+	engine.bulkPendingMu.Lock()
+	defer engine.bulkPendingMu.Unlock()
+	jurisprudence, ok := engine.bulkPending[id]
+	if ok {
+		delete(engine.bulkPending, id)
+	}
+	return jurisprudence, ok
+}
+
+//bulkAfterCallback - translate a bulk document's outcome into a ResponseChannel status.
+//A failure mirrors Persist's synchronous-path outage detection: flip elasticAvailable so a
+//bad batch during a transient ES hiccup buffers and retries instead of burning through
+//MaxFailures before the next healthcheck tick notices. Items the processor reports without
+//an id (e.g. a whole-batch transport error) aren't tracked and can't be recovered this way.
+func (engine *Engine) bulkAfterCallback(id string, err error) {
+	jurisprudence, tracked := engine.takeBulkPending(id)
 	if err != nil {
-		log.Println("[FAILED] Connect to Elasticsearch.", err)
-		log.Println("[WARNING] Retrying in ", strconv.Itoa(ElasticConfig["RetryConnectionDelay"].(int)), " seconds...")
-		time.Sleep(time.Duration(ElasticConfig["RetryConnectionDelay"].(int)) * time.Second)
-		engine.InitElastic()
+		log.Println("[FAILED][BULK] Save document ["+id+"]:", err)
+		if tracked {
+			engine.setElasticAvailable(false)
+			engine.bufferOffline(jurisprudence)
+			return
+		}
+		engine.sendStatus(http.StatusInternalServerError)
 		return
 	}
-	engine.pingElasticSearch(elasticFullURL)
+	engine.sendStatus(http.StatusOK)
 }
 
-func (engine *Engine) pingElasticSearch(elasticFullURL string) {
-	context, cancelContext := GetNewContext()
+//sendStatus - report status on ResponseChannel. channelControl's reader goroutine exits as
+//soon as shouldStop()/Done() is observed, so once shutdownElastic has started there is no
+//longer anyone reading; a blocking send at that point (e.g. a bulk processor's Close/Flush
+//still delivering After callbacks for in-flight documents) would hang the engine forever.
+//Fall back to a non-blocking, drop-and-log send once shutdown has begun.
+func (engine *Engine) sendStatus(status int) {
+	if atomic.LoadInt32(&engine.shuttingDown) == 1 {
+		select {
+		case engine.ResponseChannel <- status:
+		default:
+			log.Println("[WARNING] Dropping status after shutdown, channelControl is no longer listening.")
+		}
+		return
+	}
+	engine.ResponseChannel <- status
+}
+
+//shutdownElastic - stop the healthcheck and, in bulk mode, flush/close the backend so no
+//queued documents are lost. Outside bulk mode the backend is left open.
+func (engine *Engine) shutdownElastic() {
+	atomic.StoreInt32(&engine.shuttingDown, 1)
+	engine.Stop()
+	if !engine.UseBulk || engine.Backend == nil {
+		return
+	}
+	if err := engine.Backend.Close(engine.parentContext()); err != nil {
+		log.Println("[FAILED] Close Elasticsearch backend.", err)
+	}
+}
+
+//pingElasticSearch - ping the backend once, reporting whether it succeeded.
+func (engine *Engine) pingElasticSearch() bool {
+	ctx, cancelContext := engine.indexContext()
 	defer cancelContext()
-	info, code, err := engine.ElasticClient.Ping(elasticFullURL).Do(context)
+	version, err := engine.Backend.Ping(ctx)
 	if err != nil {
 		log.Println("[FAILED] Ping to Elasticsearch.", err)
-		log.Println("[WARNING] Retrying in ", strconv.Itoa(ElasticConfig["RetryPingDelay"].(int)), " seconds...")
-		time.Sleep(time.Duration(ElasticConfig["RetryPingDelay"].(int)) * time.Second)
-		engine.InitElastic()
-		return
+		return false
 	}
-	log.Printf("[SUCCESS] Elasticsearch returned with code %d and version %s\n", code, info.Version.Number)
+	log.Printf("[SUCCESS] Elasticsearch returned with version %s\n", version)
+	return true
 }
 
 //ConnectedToIndex - Check if the given index exist.
 func (engine *Engine) ConnectedToIndex() bool {
 	index := ElasticConfig["Index"].(string)
-	context, cancelContext := GetNewContext()
+	ctx, cancelContext := engine.indexContext()
 	defer cancelContext()
-	exists, err := engine.ElasticClient.IndexExists(index).Do(context)
+	err := engine.Backend.EnsureIndex(ctx, index, GetElasticMapping())
 	if err != nil {
 		log.Println("[FAILED] Unable to connect to index -> ["+index+"]", err)
 		return false
 	}
-	if !exists {
-		log.Println("[WARNING] Index -> [" + index + "] not found. Attempting to create...")
-		createIndex, err := engine.ElasticClient.CreateIndex(index).BodyString(GetElasticMapping()).Do(context)
-		if err != nil {
-			log.Println("[FAILED] Create index -> ["+index+"].", err)
-			return false
-		}
-		if !createIndex.Acknowledged {
-			log.Println("[WARNING] Index -> [" + index + "] was created, but not acknowledged.")
-			return false
-		}
-		log.Println("[SUCCESS] Index -> [" + index + "] was created and acknowledged.")
-		return true
-	}
-	log.Println("[SUCCESS] Index -> [" + index + "] was found, sending data to it...")
+	log.Println("[SUCCESS] Index -> [" + index + "] is ready, sending data to it...")
 	return true
 }
 
 //Persist - send data to Elasticsearch.
 func (engine *Engine) Persist(jurisprudence Jurisprudence) {
 	uid := jurisprudence.Court + "-" + engine.Base + "-" + jurisprudence.DocumentID
-	context, cancelContext := GetNewContext()
+	index := ElasticConfig["Index"].(string)
+	if !engine.isElasticAvailable() {
+		engine.bufferOffline(jurisprudence)
+		return
+	}
+	ctx, cancelContext := engine.persistContext()
 	defer cancelContext()
-	_, err := engine.ElasticClient.Index().
-		Index(ElasticConfig["Index"].(string)).
-		Type("_doc").
-		Id(uid).
-		BodyJson(jurisprudence).
-		Do(context)
+	if engine.UseBulk {
+		engine.trackBulkPending(uid, jurisprudence)
+		engine.Backend.BulkIndexDocument(ctx, index, uid, jurisprudence)
+		return
+	}
+	err := engine.Backend.IndexDocument(ctx, index, uid, jurisprudence)
 	if err != nil {
 		log.Println("[FAILED][CREATE] Save document ["+jurisprudence.DocumentID+"]["+jurisprudence.DocumentType+"]:", err)
-		engine.ResponseChannel <- http.StatusInternalServerError
+		// Don't wait for the next healthcheck tick to notice the outage: buffer this
+		// document like the background checker would, so a failure burst doesn't
+		// trip MaxFailures before elasticAvailable has a chance to flip.
+		engine.setElasticAvailable(false)
+		engine.bufferOffline(jurisprudence)
+		return
 	}
-	engine.ResponseChannel <- http.StatusOK
+	engine.sendStatus(http.StatusOK)
 }
 
 //GetDocumentType - returns the document type.
@@ -232,18 +579,39 @@ func (engine *Engine) GetDocumentType() string {
 	}
 }
 
+//channelControl - drain ResponseChannel, pacing the idle poll with the engine's Backoff
+//instead of busy-spinning while no status is pending.
 func (engine *Engine) channelControl() {
 	if engine.UseDefaultChannelControl {
 		go func() {
+			// This goroutine is the only reader of ResponseChannel. Mark shuttingDown
+			// as soon as it decides to exit, by whichever path, so sendStatus calls
+			// racing with that exit (a colly callback still in flight, a recovery
+			// backoff giving up) fall back to a non-blocking send instead of hanging.
+			atomic.StoreInt32(&engine.shuttingDown, 0)
 			defer engine.Lock.Done()
+			backoff := engine.backoffOrDefault(ControlConfig["ActionDelay"].(time.Duration) * time.Second)
+			retry := 0
 			for {
 				select {
 				case status := <-engine.ResponseChannel:
 					engine.handleChannelStatus(status)
+					retry = 0
 				default:
 					if engine.shouldStop() {
+						atomic.StoreInt32(&engine.shuttingDown, 1)
+						return
+					}
+					delay, retryable := backoff.Next(retry)
+					if !retryable {
+						atomic.StoreInt32(&engine.shuttingDown, 1)
+						return
+					}
+					if engine.sleepOrStop(delay) {
+						atomic.StoreInt32(&engine.shuttingDown, 1)
 						return
 					}
+					retry++
 				}
 			}
 		}()
@@ -252,7 +620,7 @@ func (engine *Engine) channelControl() {
 }
 
 func (engine *Engine) shouldStop() bool {
-	if engine.Failures >= engine.MaxFailures {
+	if engine.Failures >= engine.MaxFailures && engine.isElasticAvailable() {
 		log.Println("[FAILED] The engine ["+engine.Base+"] has failed ", engine.Failures, " times.")
 		return true
 	} else if engine.IsConcurrent && engine.CurrentIndex > engine.End {
@@ -273,7 +641,7 @@ func (engine *Engine) handleChannelStatus(status int) {
 
 func (engine *Engine) runAsSequential() {
 	engine.InitElastic()
-	if engine.ConnectedToIndex() {
+	if engine.Backend != nil && engine.ConnectedToIndex() {
 		engine.Recoveries = 0
 		for engine.Recoveries <= engine.MaxRecoveries {
 			engine.Collector = GetDefaultcollector()
@@ -282,14 +650,18 @@ func (engine *Engine) runAsSequential() {
 			engine.Lock.Wait()
 			if engine.done {
 				engine.logSuccess()
+				engine.shutdownElastic()
 				return
 			}
 			engine.logFailure()
 			engine.setRecoveryStart()
-			time.Sleep(ControlConfig["ActionDelay"].(time.Duration) * time.Second)
+			if engine.waitBeforeRecovery() {
+				return
+			}
 			engine.Failures = 0
 			engine.Recoveries++
 		}
+		engine.shutdownElastic()
 	}
 }
 
@@ -303,6 +675,10 @@ func (engine *Engine) runAsConcurrent() {
 		if activeEngines == 0 && maxEngines == 0 {
 			return
 		}
+		if engine.Context != nil && engine.Context.Err() != nil {
+			log.Println("[INFO] Context cancelled, shutting down concurrent replicas.")
+			return
+		}
 		select {
 		case value := <-activeEnginesChannel:
 			activeEngines += value
@@ -320,7 +696,7 @@ func (engine *Engine) runAsConcurrent() {
 func (engine Engine) spawnEngine(activeEnginesChannel chan int, maxEnginesChannel chan int, mutex *sync.Mutex) {
 	engine.InitElastic()
 	mutex.Lock()
-	connectedToIndex := engine.ConnectedToIndex()
+	connectedToIndex := engine.Backend != nil && engine.ConnectedToIndex()
 	mutex.Unlock()
 	if connectedToIndex {
 		engine.setRange(mutex)
@@ -331,20 +707,45 @@ func (engine Engine) spawnEngine(activeEnginesChannel chan int, maxEnginesChanne
 			engine.Lock.Wait()
 			if engine.done {
 				engine.logSuccess()
+				engine.shutdownElastic()
 				activeEnginesChannel <- -1
 				return
 			}
 			engine.logFailure()
 			engine.setRecoveryStart()
-			time.Sleep(ControlConfig["ActionDelay"].(time.Duration) * time.Second)
+			if engine.waitBeforeRecovery() {
+				activeEnginesChannel <- -1
+				return
+			}
 			engine.Failures = 0
 			engine.Recoveries++
 		}
+		engine.shutdownElastic()
 		maxEnginesChannel <- -1
 	}
 	activeEnginesChannel <- -1
 }
 
+//backoffOrDefault - engine.ElasticBackoff, or a ConstantBackoff{Delay: defaultDelay} when unset.
+func (engine *Engine) backoffOrDefault(defaultDelay time.Duration) Backoff {
+	if engine.ElasticBackoff != nil {
+		return engine.ElasticBackoff
+	}
+	return ConstantBackoff{Delay: defaultDelay}
+}
+
+//waitBeforeRecovery - pace the recovery loop with the engine's Backoff, keyed on Recoveries.
+//Returns true (mirroring sleepOrStop) when the caller should abort instead of retrying.
+func (engine *Engine) waitBeforeRecovery() bool {
+	backoff := engine.backoffOrDefault(ControlConfig["ActionDelay"].(time.Duration) * time.Second)
+	delay, retryable := backoff.Next(engine.Recoveries)
+	if !retryable {
+		log.Println("[FAILED] The engine [" + engine.Base + "] backoff gave up on recovery.")
+		return true
+	}
+	return engine.sleepOrStop(delay)
+}
+
 func (engine *Engine) setRange(mutex *sync.Mutex) {
 	lastRange := ControlConfig["LastGoRoutineRange"].(int)
 	if lastRange > -1 {
@@ -1,6 +1,7 @@
 package courtsdk
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -24,6 +25,30 @@ type Engine struct {
 	Collector       *colly.Collector
 	ElasticClient   *elastic.Client
 	Lock            *sync.WaitGroup
+
+	Backend        ElasticBackend
+	BackendVersion string
+
+	UseBulk           bool
+	BulkWorkers       int
+	BulkActionsLimit  int
+	BulkSizeLimit     int
+	BulkFlushInterval time.Duration
+	bulkPendingMu     sync.Mutex
+	bulkPending       map[string]Jurisprudence
+
+	HealthcheckInterval time.Duration
+	OfflineBufferSize   int
+	elasticAvailable    int32
+	offlineQueue        chan Jurisprudence
+	healthcheckStop     chan struct{}
+	shuttingDown        int32
+
+	Context         context.Context
+	IndexDeadline   time.Time
+	PersistDeadline time.Time
+
+	ElasticBackoff Backoff
 }
 
 //Jurisprudence is a structure used for serializing/deserializing data in Elasticsearch.